@@ -0,0 +1,57 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"log/slog"
+	"time"
+
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/notebooks/workspaces/backend/internal/config"
+)
+
+// NamespacePathParam is the httprouter path parameter key used for the namespace of a resource.
+const NamespacePathParam = "namespace"
+
+// ResourceNamePathParam is the httprouter path parameter key used for the name of a resource.
+const ResourceNamePathParam = "name"
+
+// App holds the dependencies shared by the backend's HTTP handlers and middleware.
+type App struct {
+	Config config.Config
+	Client ctrlclient.Client
+	Logger *slog.Logger
+
+	// AuthClient is used by the `authorize` middleware to issue SubjectAccessReviews.
+	AuthClient authorizationv1client.AuthorizationV1Interface
+
+	accessCache *accessCache
+}
+
+// NewApp creates a new App, wiring up the given config, Kubernetes client, and
+// authorization client.
+func NewApp(cfg config.Config, client ctrlclient.Client, authClient authorizationv1client.AuthorizationV1Interface, logger *slog.Logger) *App {
+	return &App{
+		Config:      cfg,
+		Client:      client,
+		AuthClient:  authClient,
+		Logger:      logger,
+		accessCache: newAccessCache(time.Duration(cfg.Auth.AccessCacheTTL)),
+	}
+}
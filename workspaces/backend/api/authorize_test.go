@@ -0,0 +1,177 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kubeflow/notebooks/workspaces/backend/internal/config"
+)
+
+func newTestAppForAuthz(allowed bool) *App {
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+
+	cfg := config.NewConfig()
+	return &App{
+		Config:      cfg,
+		AuthClient:  clientset.AuthorizationV1(),
+		accessCache: newAccessCache(time.Duration(cfg.Auth.AccessCacheTTL)),
+	}
+}
+
+func TestAuthorize_Allowed(t *testing.T) {
+	a := newTestAppForAuthz(true)
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/kubeflow-user", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	req.Header.Set("X-Forwarded-Groups", "kubeflow-admins")
+	rec := httptest.NewRecorder()
+
+	a.authorize(RouteAuthz{Resource: "workspaces", Verb: "list"})(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called when the review is allowed")
+	}
+}
+
+func TestAuthorize_Denied(t *testing.T) {
+	a := newTestAppForAuthz(false)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called when the review is denied")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/kubeflow-user", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.authorize(RouteAuthz{Resource: "workspaces", Verb: "list"})(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestAuthorize_MissingUser(t *testing.T) {
+	a := newTestAppForAuthz(true)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called without an authenticated user")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/kubeflow-user", nil)
+	rec := httptest.NewRecorder()
+
+	a.authorize(RouteAuthz{Resource: "workspaces", Verb: "list"})(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestAccessCache_HitAvoidsSecondReview(t *testing.T) {
+	reviewCount := 0
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		reviewCount++
+		return true, &authorizationv1.SubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true},
+		}, nil
+	})
+
+	cfg := config.NewConfig()
+	a := &App{
+		Config:      cfg,
+		AuthClient:  clientset.AuthorizationV1(),
+		accessCache: newAccessCache(time.Duration(cfg.Auth.AccessCacheTTL)),
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/kubeflow-user", nil)
+		req.Header.Set("X-Forwarded-User", "jane@example.com")
+		rec := httptest.NewRecorder()
+		a.authorize(RouteAuthz{Resource: "workspaces", Verb: "list"})(next).ServeHTTP(rec, req)
+	}
+
+	if reviewCount != 1 {
+		t.Errorf("expected a single SubjectAccessReview to be issued across repeated checks, got %d", reviewCount)
+	}
+}
+
+func TestCheckAccess_NonResourceRequest(t *testing.T) {
+	var created *authorizationv1.SubjectAccessReview
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		created = action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		return true, &authorizationv1.SubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true},
+		}, nil
+	})
+
+	cfg := config.NewConfig()
+	a := &App{
+		Config:      cfg,
+		AuthClient:  clientset.AuthorizationV1(),
+		accessCache: newAccessCache(time.Duration(cfg.Auth.AccessCacheTTL)),
+	}
+
+	allowed, err := a.checkAccess(context.Background(), "jane@example.com", nil, "", RouteAuthz{
+		ResourceRequest: false,
+		Verb:            "get",
+		Path:            "/healthz",
+	})
+	if err != nil {
+		t.Fatalf("checkAccess() returned an error: %v", err)
+	}
+	if !allowed {
+		t.Fatalf("expected checkAccess() to report allowed")
+	}
+
+	if created.Spec.ResourceAttributes != nil {
+		t.Errorf("expected ResourceAttributes to be nil for a non-resource request, got %+v", created.Spec.ResourceAttributes)
+	}
+	if created.Spec.NonResourceAttributes == nil {
+		t.Fatalf("expected NonResourceAttributes to be set for a non-resource request")
+	}
+	if got, want := created.Spec.NonResourceAttributes.Path, "/healthz"; got != want {
+		t.Errorf("NonResourceAttributes.Path = %q, want %q", got, want)
+	}
+	if got, want := created.Spec.NonResourceAttributes.Verb, "get"; got != want {
+		t.Errorf("NonResourceAttributes.Verb = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	errMsgPathParamsInvalid = "invalid path parameters"
+)
+
+// errorEnvelope is the JSON shape returned for all error responses.
+type errorEnvelope struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Message string `json:"message"`
+	Details any    `json:"details,omitempty"`
+}
+
+// writeJSONError writes `message` (and optional `details`) as a JSON error envelope with the given status code.
+func (a *App) writeJSONError(w http.ResponseWriter, r *http.Request, status int, message string, details any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(errorEnvelope{Error: errorBody{Message: message, Details: details}}); err != nil {
+		a.logError(r, err)
+	}
+}
+
+func (a *App) logError(r *http.Request, err error) {
+	if a.Logger != nil {
+		a.Logger.Error(err.Error(), "method", r.Method, "uri", r.URL.RequestURI())
+	}
+}
+
+// serverErrorResponse writes a generic 500 response and logs the underlying error.
+func (a *App) serverErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	a.logError(r, err)
+	a.writeJSONError(w, r, http.StatusInternalServerError, "the server encountered a problem and could not process your request", nil)
+}
+
+// notFoundResponse writes a structured 404 response.
+func (a *App) notFoundResponse(w http.ResponseWriter, r *http.Request, message string) {
+	a.writeJSONError(w, r, http.StatusNotFound, message, nil)
+}
+
+// forbiddenResponse writes a structured 403 response.
+func (a *App) forbiddenResponse(w http.ResponseWriter, r *http.Request, message string, details any) {
+	a.writeJSONError(w, r, http.StatusForbidden, message, details)
+}
+
+// failedValidationResponse writes a structured 422 response for one or more field validation errors.
+func (a *App) failedValidationResponse(w http.ResponseWriter, r *http.Request, message string, valErrs field.ErrorList, extra any) {
+	details := map[string]any{
+		"fieldErrors": valErrs,
+	}
+	if extra != nil {
+		details["context"] = extra
+	}
+	a.writeJSONError(w, r, http.StatusUnprocessableEntity, message, details)
+}
@@ -19,6 +19,8 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -39,39 +41,137 @@ func (a *App) recoverPanic(next http.Handler) http.Handler {
 	})
 }
 
+// enableCORS is a middleware that enforces the configured CORS policy (see `config.CORSConfig`),
+// echoing the request `Origin` only when it matches the allowlist and short-circuiting preflight
+// (`OPTIONS`) requests with a 204 response carrying the negotiated headers.
 func (a *App) enableCORS(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// TODO(ederign) restrict CORS to a much smaller set of trusted origins.
-		// TODO(ederign) deal with preflight requests
-		w.Header().Set("Access-Control-Allow-Origin", "*")
+		cors := a.Config.CORS
 
-		next.ServeHTTP(w, r)
-	})
-}
+		w.Header().Add("Vary", "Origin")
 
-// validatePathParams is a middleware that validates path parameters - currently only namespace and resource name parameters are validated
-func (a *App) validatePathParams(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Get the path parameters from the request context
-		params := httprouter.ParamsFromContext(r.Context())
-		if params == nil {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !corsOriginAllowed(cors.AllowedOrigins, origin) {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		var valErrs field.ErrorList
-		for _, param := range params {
-			// Only validate namespace and resource name parameters
-			if param.Key == NamespacePathParam || param.Key == ResourceNamePathParam {
-				valErrs = append(valErrs, helper.ValidateFieldIsDNS1123Subdomain(field.NewPath(param.Key), param.Value)...)
-			}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if cors.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
 		}
+		if len(cors.ExposedHeaders) > 0 {
+			w.Header().Set("Access-Control-Expose-Headers", strings.Join(cors.ExposedHeaders, ", "))
+		}
+
+		// preflight requests carry a requested method and are never followed by a body,
+		// so we short-circuit them here rather than passing them on to the handler.
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
 
-		if len(valErrs) > 0 {
-			a.failedValidationResponse(w, r, errMsgPathParamsInvalid, valErrs, nil)
+			if len(cors.AllowedMethods) > 0 {
+				w.Header().Set("Access-Control-Allow-Methods", strings.Join(cors.AllowedMethods, ", "))
+			}
+			if len(cors.AllowedHeaders) > 0 {
+				w.Header().Set("Access-Control-Allow-Headers", strings.Join(cors.AllowedHeaders, ", "))
+			}
+			if cors.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(cors.MaxAge))
+			}
+
+			w.WriteHeader(http.StatusNoContent)
 			return
 		}
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// corsOriginAllowed reports whether `origin` matches one of the configured `allowedOrigins`.
+// entries are matched exactly, except for a single leading "*" label (e.g. "https://*.example.com"),
+// which matches any single subdomain label in that position.
+func corsOriginAllowed(allowedOrigins []string, origin string) bool {
+	for _, allowed := range allowedOrigins {
+		if allowed == origin {
+			return true
+		}
+		if corsOriginMatchesWildcard(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func corsOriginMatchesWildcard(pattern, origin string) bool {
+	const wildcard = "*."
+	idx := strings.Index(pattern, wildcard)
+	if idx == -1 {
+		return false
+	}
+
+	prefix := pattern[:idx]
+	suffix := pattern[idx+len(wildcard)-1:] // keep the leading "."
+
+	if !strings.HasPrefix(origin, prefix) {
+		return false
+	}
+	rest := origin[len(prefix):]
+
+	// the wildcard must match exactly one non-empty label, e.g. "foo" in "foo.example.com",
+	// not "foo.bar" in "foo.bar.example.com".
+	dot := strings.Index(rest, suffix)
+	if dot <= 0 {
+		return false
+	}
+	if strings.Contains(rest[:dot], ".") {
+		return false
+	}
+
+	return rest[dot:] == suffix
+}
+
+// PathParamValidators maps a route's path parameter keys to the name of the
+// `helper.Validators` entry that should validate them. Path parameters with no
+// entry in the map are left unvalidated.
+type PathParamValidators map[string]string
+
+// validatePathParams is a middleware factory that validates each path parameter
+// named in `validators` using the correspondingly-named entry from `helper.Validators`,
+// aggregating any violations into the existing `failedValidationResponse`. This lets
+// routes for identifiers that are not namespace-like names (e.g. UUIDs, qualified
+// names) reuse the same middleware with a different validator.
+func (a *App) validatePathParams(validators PathParamValidators) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			params := httprouter.ParamsFromContext(r.Context())
+			if params == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var valErrs field.ErrorList
+			for _, param := range params {
+				validatorName, ok := validators[param.Key]
+				if !ok {
+					continue
+				}
+
+				validate, ok := helper.Validators[validatorName]
+				if !ok {
+					a.serverErrorResponse(w, r, fmt.Errorf("no such path param validator: %q", validatorName))
+					return
+				}
+
+				valErrs = append(valErrs, validate(field.NewPath(param.Key), param.Value)...)
+			}
+
+			if len(valErrs) > 0 {
+				a.failedValidationResponse(w, r, errMsgPathParamsInvalid, valErrs, nil)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
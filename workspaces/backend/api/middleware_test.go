@@ -0,0 +1,157 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/notebooks/workspaces/backend/internal/config"
+)
+
+func newTestAppForCORS(cors config.CORSConfig) *App {
+	return &App{
+		Config: config.Config{CORS: cors},
+	}
+}
+
+func TestEnableCORS_AllowlistMatch(t *testing.T) {
+	a := newTestAppForCORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://*.example.com"},
+	})
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces", nil)
+	req.Header.Set("Origin", "https://studio.example.com")
+	rec := httptest.NewRecorder()
+
+	a.enableCORS(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called")
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://studio.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, "https://studio.example.com")
+	}
+	if got := rec.Header().Get("Vary"); got != "Origin" {
+		t.Errorf("Vary = %q, want %q", got, "Origin")
+	}
+}
+
+func TestEnableCORS_Mismatch(t *testing.T) {
+	a := newTestAppForCORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://example.com"},
+	})
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces", nil)
+	req.Header.Set("Origin", "https://evil.com")
+	rec := httptest.NewRecorder()
+
+	a.enableCORS(next).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want empty", got)
+	}
+}
+
+func TestEnableCORS_Credentialed(t *testing.T) {
+	a := newTestAppForCORS(config.CORSConfig{
+		AllowedOrigins:   []string{"https://example.com"},
+		AllowCredentials: true,
+		ExposedHeaders:   []string{"X-Request-Id"},
+	})
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces", nil)
+	req.Header.Set("Origin", "https://example.com")
+	rec := httptest.NewRecorder()
+
+	a.enableCORS(next).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+		t.Errorf("Access-Control-Allow-Credentials = %q, want %q", got, "true")
+	}
+	if got := rec.Header().Get("Access-Control-Expose-Headers"); got != "X-Request-Id" {
+		t.Errorf("Access-Control-Expose-Headers = %q, want %q", got, "X-Request-Id")
+	}
+}
+
+func TestEnableCORS_PreflightCaching(t *testing.T) {
+	a := newTestAppForCORS(config.CORSConfig{
+		AllowedOrigins: []string{"https://example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type"},
+		MaxAge:         600,
+	})
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/workspaces", nil)
+	req.Header.Set("Origin", "https://example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec := httptest.NewRecorder()
+
+	a.enableCORS(next).ServeHTTP(rec, req)
+
+	if handlerCalled {
+		t.Fatalf("expected preflight request to short-circuit before reaching next handler")
+	}
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Methods"); got != "GET, POST" {
+		t.Errorf("Access-Control-Allow-Methods = %q, want %q", got, "GET, POST")
+	}
+	if got := rec.Header().Get("Access-Control-Max-Age"); got != "600" {
+		t.Errorf("Access-Control-Max-Age = %q, want %q", got, "600")
+	}
+}
+
+func TestCorsOriginAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  []string
+		origin   string
+		expected bool
+	}{
+		{"exact match", []string{"https://example.com"}, "https://example.com", true},
+		{"exact mismatch", []string{"https://example.com"}, "https://other.com", false},
+		{"wildcard match", []string{"https://*.example.com"}, "https://studio.example.com", true},
+		{"wildcard requires a label", []string{"https://*.example.com"}, "https://example.com", false},
+		{"wildcard mismatched suffix", []string{"https://*.example.com"}, "https://studio.example.org", false},
+		{"wildcard rejects multi-label subdomain", []string{"https://*.example.com"}, "https://evil.attacker.example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := corsOriginAllowed(tt.allowed, tt.origin); got != tt.expected {
+				t.Errorf("corsOriginAllowed(%v, %q) = %v, want %v", tt.allowed, tt.origin, got, tt.expected)
+			}
+		})
+	}
+}
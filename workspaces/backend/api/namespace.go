@@ -0,0 +1,99 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// missingNamespaceError indicates that a namespace does not exist in the cluster.
+type missingNamespaceError struct {
+	namespace string
+}
+
+func (e *missingNamespaceError) Error() string {
+	return fmt.Sprintf("namespace not found: %s", e.namespace)
+}
+
+// IsMissingNamespaceErr reports whether `err` indicates that a namespace does not exist.
+func IsMissingNamespaceErr(err error) bool {
+	var target *missingNamespaceError
+	return errors.As(err, &target)
+}
+
+// ExtractMissingNamespaceFromErr returns the missing namespace name carried by `err`, if any.
+func ExtractMissingNamespaceFromErr(err error) (string, bool) {
+	var target *missingNamespaceError
+	if errors.As(err, &target) {
+		return target.namespace, true
+	}
+	return "", false
+}
+
+// verifyNamespaceExists is a middleware that rejects the request with a 404 when the
+// `namespace` path parameter does not refer to a namespace that exists in the cluster.
+// it must run after `validatePathParams` so that the namespace has already been
+// validated as a well-formed name. the existence check is served from the
+// controller-runtime client's informer-backed cache, so it does not hit the API
+// server on every request.
+func (a *App) verifyNamespaceExists(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := httprouter.ParamsFromContext(r.Context())
+		if params == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		namespace := params.ByName(NamespacePathParam)
+		if namespace == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := a.checkNamespaceExists(r.Context(), namespace); err != nil {
+			if IsMissingNamespaceErr(err) {
+				a.notFoundResponse(w, r, err.Error())
+				return
+			}
+			a.serverErrorResponse(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkNamespaceExists looks up `namespace` via the cached controller-runtime client,
+// returning a `missingNamespaceError` if it does not exist.
+func (a *App) checkNamespaceExists(ctx context.Context, namespace string) error {
+	var ns corev1.Namespace
+	if err := a.Client.Get(ctx, ctrlclient.ObjectKey{Name: namespace}, &ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return &missingNamespaceError{namespace: namespace}
+		}
+		return fmt.Errorf("failed to get namespace %q: %w", namespace, err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// enforceNamespacePolicy is a middleware that rejects the request with a 403 when
+// the `namespace` path parameter matches one of the configured `ReservedNamespaces`
+// glob patterns, unless the authenticated user or one of their groups is on the
+// `AllowedUsers`/`AllowedGroups` bypass list. it must run after `validatePathParams`
+// so that the namespace has already been validated as a well-formed name.
+func (a *App) enforceNamespacePolicy(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := httprouter.ParamsFromContext(r.Context())
+		if params == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		namespace := params.ByName(NamespacePathParam)
+		if namespace == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		pattern, reserved := a.matchesReservedNamespace(namespace)
+		if !reserved || a.bypassesNamespacePolicy(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		a.forbiddenResponse(w, r, fmt.Sprintf("namespace %q is reserved", namespace), map[string]string{
+			"namespace": namespace,
+			"pattern":   pattern,
+		})
+	})
+}
+
+// matchesReservedNamespace reports whether `namespace` matches one of the configured
+// `ReservedNamespaces` glob patterns, returning the matching pattern if so. namespace
+// names aren't filesystem paths, so this uses `path.Match` rather than
+// `path/filepath.Match`, whose backslash-escaping semantics vary by build platform.
+func (a *App) matchesReservedNamespace(namespace string) (string, bool) {
+	for _, pattern := range a.Config.NamespacePolicy.ReservedNamespaces {
+		if ok, err := path.Match(pattern, namespace); err == nil && ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// bypassesNamespacePolicy reports whether the caller identified by the request's auth
+// headers is on the `AllowedUsers`/`AllowedGroups` bypass list.
+func (a *App) bypassesNamespacePolicy(r *http.Request) bool {
+	policy := a.Config.NamespacePolicy
+
+	if user := r.Header.Get(a.Config.Auth.UserHeader); user != "" && stringSliceContains(policy.AllowedUsers, user) {
+		return true
+	}
+
+	if raw := r.Header.Get(a.Config.Auth.GroupsHeader); raw != "" {
+		for _, group := range strings.Split(raw, ",") {
+			if stringSliceContains(policy.AllowedGroups, group) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func stringSliceContains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
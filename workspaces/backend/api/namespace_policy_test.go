@@ -0,0 +1,161 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/kubeflow/notebooks/workspaces/backend/internal/config"
+)
+
+func newTestAppForNamespacePolicy(policy config.NamespacePolicyConfig) *App {
+	cfg := config.NewConfig()
+	cfg.NamespacePolicy = policy
+	return &App{Config: cfg}
+}
+
+func TestEnforceNamespacePolicy_ExactMatch(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"default"},
+	})
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called for a reserved namespace")
+	})
+
+	req := requestWithNamespaceParam("default")
+	rec := httptest.NewRecorder()
+
+	a.enforceNamespacePolicy(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestEnforceNamespacePolicy_WildcardMatch(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"kube-*"},
+	})
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called for a reserved namespace")
+	})
+
+	req := requestWithNamespaceParam("kube-system")
+	rec := httptest.NewRecorder()
+
+	a.enforceNamespacePolicy(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestEnforceNamespacePolicy_NonReservedNamespacePasses(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"kube-*", "default"},
+	})
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := requestWithNamespaceParam("kubeflow-user")
+	rec := httptest.NewRecorder()
+
+	a.enforceNamespacePolicy(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called for a non-reserved namespace")
+	}
+}
+
+func TestEnforceNamespacePolicy_BypassGroup(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"kube-*"},
+		AllowedGroups:      []string{"platform-admins"},
+	})
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := requestWithNamespaceParam("kube-system")
+	req.Header.Set("X-Forwarded-Groups", "developers,platform-admins")
+	rec := httptest.NewRecorder()
+
+	a.enforceNamespacePolicy(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called for a bypass group member")
+	}
+}
+
+func TestEnforceNamespacePolicy_BypassUser(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"default"},
+		AllowedUsers:       []string{"jane@example.com"},
+	})
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := requestWithNamespaceParam("default")
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.enforceNamespacePolicy(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called for a bypass user")
+	}
+}
+
+func TestEnforceNamespacePolicy_RunsAfterPathParamValidation(t *testing.T) {
+	a := newTestAppForNamespacePolicy(config.NamespacePolicyConfig{
+		ReservedNamespaces: []string{"default"},
+	})
+
+	handlerCalled := false
+	chain := a.validatePathParams(namespaceValidators)(a.enforceNamespacePolicy(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	params := httprouter.Params{{Key: NamespacePathParam, Value: "Not_A_Valid_Name"}}
+	req = req.WithContext(context.WithValue(req.Context(), httprouter.ParamsKey, params))
+	rec := httptest.NewRecorder()
+
+	chain.ServeHTTP(rec, req)
+
+	if handlerCalled {
+		t.Fatalf("expected the DNS1123 validation failure to short-circuit before the namespace policy check")
+	}
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestAppWithNamespaces(names ...string) *App {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	var objs []runtime.Object
+	for _, name := range names {
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	return &App{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+	}
+}
+
+func requestWithNamespaceParam(namespace string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/"+namespace, nil)
+	params := httprouter.Params{{Key: NamespacePathParam, Value: namespace}}
+	ctx := context.WithValue(req.Context(), httprouter.ParamsKey, params)
+	return req.WithContext(ctx)
+}
+
+func TestVerifyNamespaceExists_Present(t *testing.T) {
+	a := newTestAppWithNamespaces("kubeflow-user")
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	req := requestWithNamespaceParam("kubeflow-user")
+	rec := httptest.NewRecorder()
+
+	a.verifyNamespaceExists(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called for an existing namespace")
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestVerifyNamespaceExists_Missing(t *testing.T) {
+	a := newTestAppWithNamespaces("kubeflow-user")
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called for a missing namespace")
+	})
+
+	req := requestWithNamespaceParam("does-not-exist")
+	rec := httptest.NewRecorder()
+
+	a.verifyNamespaceExists(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestCheckNamespaceExists_MissingErr(t *testing.T) {
+	a := newTestAppWithNamespaces()
+
+	err := a.checkNamespaceExists(context.Background(), "does-not-exist")
+	if !IsMissingNamespaceErr(err) {
+		t.Fatalf("expected IsMissingNamespaceErr to be true, got err: %v", err)
+	}
+
+	name, ok := ExtractMissingNamespaceFromErr(err)
+	if !ok || name != "does-not-exist" {
+		t.Errorf("ExtractMissingNamespaceFromErr() = (%q, %v), want (%q, true)", name, ok, "does-not-exist")
+	}
+}
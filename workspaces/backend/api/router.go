@@ -0,0 +1,89 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	PathPrefix     = "/api/v1"
+	WorkspacesPath = PathPrefix + "/workspaces/:" + NamespacePathParam
+	WorkspacePath  = WorkspacesPath + "/:" + ResourceNamePathParam
+)
+
+// namespaceValidators validates the `namespace` path parameter carried by every
+// namespaced route.
+var namespaceValidators = PathParamValidators{
+	NamespacePathParam: "dns1123Subdomain",
+}
+
+// namespaceAndNameValidators additionally validates the `name` path parameter of a
+// specific resource. Routes for resources whose names don't follow the DNS1123
+// subdomain rule (e.g. workspacekinds, images) should declare their own map instead.
+var namespaceAndNameValidators = PathParamValidators{
+	NamespacePathParam:    "dns1123Subdomain",
+	ResourceNamePathParam: "dns1123Subdomain",
+}
+
+// Routes builds the httprouter.Router for the backend, wiring each registered
+// route through the shared middleware chain before the per-resource middleware
+// and handler that are specific to that route.
+func (a *App) Routes() http.Handler {
+	router := httprouter.New()
+
+	router.HandlerFunc(http.MethodGet, WorkspacesPath, a.namespacedChain(namespaceValidators, RouteAuthz{
+		APIGroup:               "kubeflow.org",
+		Resource:               "workspaces",
+		Verb:                   "list",
+		ResourceRequest:        true,
+		NamespaceFromPathParam: true,
+	}, a.handleWorkspacesList))
+
+	router.HandlerFunc(http.MethodGet, WorkspacePath, a.namespacedChain(namespaceAndNameValidators, RouteAuthz{
+		APIGroup:               "kubeflow.org",
+		Resource:               "workspaces",
+		Verb:                   "get",
+		ResourceRequest:        true,
+		NamespaceFromPathParam: true,
+	}, a.handleWorkspaceGet))
+
+	return a.recoverPanic(a.enableCORS(router))
+}
+
+// namespacedChain wraps `next` with the middleware common to every route that
+// carries a `namespace` path parameter: path parameter validation against
+// `validators`, the reserved namespace policy, a SubjectAccessReview for `attrs`,
+// and finally a check that the namespace actually exists in the cluster.
+//
+// authorize must run before verifyNamespaceExists: namespaces in this platform
+// map 1:1 to tenants, so if the existence check ran first an unauthorized caller
+// could enumerate which namespaces exist from the 404-vs-not-404 response alone,
+// without ever passing the SubjectAccessReview gate.
+func (a *App) namespacedChain(validators PathParamValidators, attrs RouteAuthz, next http.HandlerFunc) http.HandlerFunc {
+	return a.validatePathParams(validators)(a.enforceNamespacePolicy(a.authorize(attrs)(a.verifyNamespaceExists(next)))).ServeHTTP
+}
+
+func (a *App) handleWorkspacesList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+func (a *App) handleWorkspaceGet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/notebooks/workspaces/backend/internal/config"
+)
+
+// newTestAppForRouter builds an App wired the same way production code would,
+// with a fake controller-runtime client seeded with `existingNamespaces` and a
+// fake authorization client that allows or denies every SubjectAccessReview
+// according to `authzAllowed`.
+func newTestAppForRouter(t *testing.T, authzAllowed bool, existingNamespaces ...string) *App {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	var objs []runtime.Object
+	for _, name := range existingNamespaces {
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	authzClient := fake.NewSimpleClientset()
+	authzClient.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: authzAllowed},
+		}, nil
+	})
+
+	cfg := config.NewConfig()
+	return NewApp(
+		cfg,
+		ctrlfake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		authzClient.AuthorizationV1(),
+		nil,
+	)
+}
+
+func TestRoutes_ReservedNamespaceIsRejectedBeforeAuthzOrExistence(t *testing.T) {
+	// "default" is reserved out of the box (see config.NewConfig), and doesn't exist
+	// in the fake cluster, and the fake authz client would deny the review anyway -
+	// but the reserved-namespace check must win, and must do so with a 403.
+	a := newTestAppForRouter(t, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/default", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.Routes().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestRoutes_UnauthorizedCallerCannotEnumerateNamespaces(t *testing.T) {
+	// regression test for the namespace-enumeration bug: an unauthorized caller must
+	// see the same 403 whether or not "does-not-exist" actually exists in the
+	// cluster - authorize must run, and must deny, before verifyNamespaceExists ever
+	// gets a chance to answer with a 404.
+	a := newTestAppForRouter(t, false)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/does-not-exist", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.Routes().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d (not %d, which would leak namespace existence)", rec.Code, http.StatusForbidden, http.StatusNotFound)
+	}
+}
+
+func TestRoutes_AuthorizedCallerGetsNotFoundForMissingNamespace(t *testing.T) {
+	a := newTestAppForRouter(t, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/does-not-exist", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.Routes().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestRoutes_AuthorizedCallerReachesHandlerForExistingNamespace(t *testing.T) {
+	a := newTestAppForRouter(t, true, "kubeflow-user")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/workspaces/kubeflow-user", nil)
+	req.Header.Set("X-Forwarded-User", "jane@example.com")
+	rec := httptest.NewRecorder()
+
+	a.Routes().ServeHTTP(rec, req)
+
+	// handleWorkspacesList is a placeholder that always answers 501, so reaching it
+	// confirms the full chain (validation, policy, authz, existence) let the request
+	// through.
+	if rec.Code != http.StatusNotImplemented {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotImplemented)
+	}
+}
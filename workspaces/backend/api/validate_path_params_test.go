@@ -0,0 +1,89 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+func requestWithParams(params httprouter.Params) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx := context.WithValue(req.Context(), httprouter.ParamsKey, params)
+	return req.WithContext(ctx)
+}
+
+func TestValidatePathParams_UsesDeclaredValidator(t *testing.T) {
+	a := &App{}
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	validators := PathParamValidators{"id": "uuid"}
+	req := requestWithParams(httprouter.Params{{Key: "id", Value: "123e4567-e89b-12d3-a456-426614174000"}})
+	rec := httptest.NewRecorder()
+
+	a.validatePathParams(validators)(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called for a valid UUID")
+	}
+}
+
+func TestValidatePathParams_RejectsInvalidValue(t *testing.T) {
+	a := &App{}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("next handler should not be called for an invalid value")
+	})
+
+	validators := PathParamValidators{"id": "uuid"}
+	req := requestWithParams(httprouter.Params{{Key: "id", Value: "not-a-uuid"}})
+	rec := httptest.NewRecorder()
+
+	a.validatePathParams(validators)(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestValidatePathParams_SkipsUndeclaredParams(t *testing.T) {
+	a := &App{}
+
+	handlerCalled := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerCalled = true
+	})
+
+	// "page" has no registered validator, so any value should pass through untouched.
+	validators := PathParamValidators{"id": "uuid"}
+	req := requestWithParams(httprouter.Params{{Key: "page", Value: "!!!not-validated!!!"}})
+	rec := httptest.NewRecorder()
+
+	a.validatePathParams(validators)(next).ServeHTTP(rec, req)
+
+	if !handlerCalled {
+		t.Fatalf("expected next handler to be called when the only param present has no declared validator")
+	}
+}
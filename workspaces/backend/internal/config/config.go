@@ -0,0 +1,128 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config defines the configuration for the workspaces backend,
+// loadable from a YAML file. Environment variables are not read.
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// CORSConfig configures the `enableCORS` middleware.
+type CORSConfig struct {
+	// AllowedOrigins is the list of origins permitted to make cross-origin requests.
+	// entries support exact matches (e.g. "https://example.com") as well as simple
+	// wildcard patterns (e.g. "https://*.example.com").
+	AllowedOrigins []string `json:"allowedOrigins"`
+
+	// AllowedMethods is the list of HTTP methods allowed for cross-origin requests.
+	AllowedMethods []string `json:"allowedMethods"`
+
+	// AllowedHeaders is the list of request headers allowed for cross-origin requests.
+	AllowedHeaders []string `json:"allowedHeaders"`
+
+	// ExposedHeaders is the list of response headers exposed to cross-origin callers.
+	ExposedHeaders []string `json:"exposedHeaders"`
+
+	// AllowCredentials controls whether the `Access-Control-Allow-Credentials` header is set.
+	AllowCredentials bool `json:"allowCredentials"`
+
+	// MaxAge is the number of seconds a preflight response may be cached for, via
+	// the `Access-Control-Max-Age` header. A zero value omits the header.
+	MaxAge int `json:"maxAge"`
+}
+
+// AuthConfig configures the `authorize` middleware's SubjectAccessReview checks.
+type AuthConfig struct {
+	// UserHeader is the request header carrying the authenticated username,
+	// as set by the cluster's authenticating proxy.
+	UserHeader string `json:"userHeader"`
+
+	// GroupsHeader is the request header carrying the authenticated user's
+	// comma-separated groups.
+	GroupsHeader string `json:"groupsHeader"`
+
+	// AccessCacheTTL is how long an allow/deny decision is cached for a given
+	// (user, groups, attributes) tuple, to amortize repeated checks from chatty
+	// UI polling. A zero value disables caching.
+	AccessCacheTTL Duration `json:"accessCacheTTL"`
+}
+
+// NamespacePolicyConfig configures the `enforceNamespacePolicy` middleware.
+type NamespacePolicyConfig struct {
+	// ReservedNamespaces is the list of namespace patterns that are off-limits to
+	// ordinary callers. entries support the glob syntax of `path.Match`,
+	// e.g. "kube-*", "openshift-*", "default".
+	ReservedNamespaces []string `json:"reservedNamespaces"`
+
+	// AllowedGroups is the list of groups that may bypass the reserved namespace check.
+	AllowedGroups []string `json:"allowedGroups"`
+
+	// AllowedUsers is the list of users that may bypass the reserved namespace check.
+	AllowedUsers []string `json:"allowedUsers"`
+}
+
+// Config is the root configuration for the workspaces backend.
+type Config struct {
+	// CORS configures the cross-origin resource sharing middleware.
+	CORS CORSConfig `json:"cors"`
+
+	// Auth configures the SubjectAccessReview-based authorization middleware.
+	Auth AuthConfig `json:"auth"`
+
+	// NamespacePolicy configures the reserved/allowed namespace policy middleware.
+	NamespacePolicy NamespacePolicyConfig `json:"namespacePolicy"`
+}
+
+// NewConfig returns a Config with the backend's default values.
+func NewConfig() Config {
+	return Config{
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+			AllowedHeaders: []string{"Content-Type", "Authorization"},
+		},
+		Auth: AuthConfig{
+			UserHeader:     "X-Forwarded-User",
+			GroupsHeader:   "X-Forwarded-Groups",
+			AccessCacheTTL: Duration(30 * time.Second),
+		},
+		NamespacePolicy: NamespacePolicyConfig{
+			ReservedNamespaces: []string{"kube-*", "openshift-*", "default"},
+		},
+	}
+}
+
+// LoadFromFile reads a Config from the YAML file at `path`, falling back to
+// the backend's defaults for any field that is not set.
+func LoadFromFile(path string) (Config, error) {
+	cfg := NewConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config file %q: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("failed to parse config file %q: %w", path, err)
+	}
+
+	return cfg, nil
+}
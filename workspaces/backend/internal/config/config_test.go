@@ -0,0 +1,85 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeTestConfigFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write test config file: %v", err)
+	}
+	return path
+}
+
+func TestLoadFromFile_ParsesDurationStrings(t *testing.T) {
+	path := writeTestConfigFile(t, `
+auth:
+  userHeader: X-Forwarded-User
+  accessCacheTTL: 45s
+`)
+
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() returned an error: %v", err)
+	}
+
+	if got, want := time.Duration(cfg.Auth.AccessCacheTTL), 45*time.Second; got != want {
+		t.Errorf("Auth.AccessCacheTTL = %v, want %v", got, want)
+	}
+	if cfg.Auth.UserHeader != "X-Forwarded-User" {
+		t.Errorf("Auth.UserHeader = %q, want %q", cfg.Auth.UserHeader, "X-Forwarded-User")
+	}
+}
+
+func TestLoadFromFile_FallsBackToDefaults(t *testing.T) {
+	path := writeTestConfigFile(t, `
+cors:
+  allowedOrigins:
+    - https://example.com
+`)
+
+	cfg, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadFromFile() returned an error: %v", err)
+	}
+
+	if got, want := time.Duration(cfg.Auth.AccessCacheTTL), 30*time.Second; got != want {
+		t.Errorf("Auth.AccessCacheTTL = %v, want the default of %v", got, want)
+	}
+	if len(cfg.CORS.AllowedOrigins) != 1 || cfg.CORS.AllowedOrigins[0] != "https://example.com" {
+		t.Errorf("CORS.AllowedOrigins = %v, want [https://example.com]", cfg.CORS.AllowedOrigins)
+	}
+}
+
+func TestLoadFromFile_RejectsInvalidDuration(t *testing.T) {
+	path := writeTestConfigFile(t, `
+auth:
+  accessCacheTTL: "not-a-duration"
+`)
+
+	if _, err := LoadFromFile(path); err == nil {
+		t.Fatalf("expected LoadFromFile() to return an error for an invalid duration")
+	}
+}
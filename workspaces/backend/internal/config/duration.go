@@ -0,0 +1,54 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration wraps time.Duration so it can be loaded from a YAML/JSON value written
+// the way an operator would naturally write it (e.g. `30s`, `5m`), rather than only
+// as a raw nanosecond integer.
+type Duration time.Duration
+
+// UnmarshalJSON accepts either a duration string (e.g. "30s") or a plain number of
+// nanoseconds, matching the two forms `sigs.k8s.io/yaml` can produce from YAML.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var asString string
+	if err := json.Unmarshal(data, &asString); err == nil {
+		parsed, err := time.ParseDuration(asString)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", asString, err)
+		}
+		*d = Duration(parsed)
+		return nil
+	}
+
+	var asNanoseconds int64
+	if err := json.Unmarshal(data, &asNanoseconds); err != nil {
+		return fmt.Errorf("duration must be a string (e.g. \"30s\") or a number of nanoseconds: %w", err)
+	}
+	*d = Duration(asNanoseconds)
+	return nil
+}
+
+// MarshalJSON renders the duration in its string form (e.g. "30s").
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helper contains small, reusable helpers shared across the backend.
+package helper
+
+import (
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// FieldValidator validates a single string value, returning any errors rooted at fldPath.
+type FieldValidator func(fldPath *field.Path, value string) field.ErrorList
+
+// Validators is the registry of named validators that the router can associate
+// with a path parameter at registration time, modeled after Kubernetes' own
+// collection of string format checks.
+var Validators = map[string]FieldValidator{
+	"dns1123Label":     ValidateFieldIsDNS1123Label,
+	"dns1123Subdomain": ValidateFieldIsDNS1123Subdomain,
+	"dns1035Label":     ValidateFieldIsDNS1035Label,
+	"uuid":             ValidateFieldIsUUID,
+	"qualifiedName":    ValidateFieldIsQualifiedName,
+}
+
+// ValidateFieldIsDNS1123Label validates that `value` is a valid DNS1123 label,
+// returning a field.ErrorList with an entry rooted at `fldPath` for each violation.
+func ValidateFieldIsDNS1123Label(fldPath *field.Path, value string) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1123Label(value) {
+		errs = append(errs, field.Invalid(fldPath, value, msg))
+	}
+	return errs
+}
+
+// ValidateFieldIsDNS1123Subdomain validates that `value` is a valid DNS1123 subdomain,
+// returning a field.ErrorList with an entry rooted at `fldPath` for each violation.
+func ValidateFieldIsDNS1123Subdomain(fldPath *field.Path, value string) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1123Subdomain(value) {
+		errs = append(errs, field.Invalid(fldPath, value, msg))
+	}
+	return errs
+}
+
+// ValidateFieldIsDNS1035Label validates that `value` is a valid DNS1035 label,
+// returning a field.ErrorList with an entry rooted at `fldPath` for each violation.
+func ValidateFieldIsDNS1035Label(fldPath *field.Path, value string) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1035Label(value) {
+		errs = append(errs, field.Invalid(fldPath, value, msg))
+	}
+	return errs
+}
+
+// ValidateFieldIsQualifiedName validates that `value` is a valid Kubernetes qualified
+// name (e.g. "prefix.example.com/name" or "name"), returning a field.ErrorList with an
+// entry rooted at `fldPath` for each violation.
+func ValidateFieldIsQualifiedName(fldPath *field.Path, value string) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsQualifiedName(value) {
+		errs = append(errs, field.Invalid(fldPath, value, msg))
+	}
+	return errs
+}
+
+// uuidRE matches a canonical, hyphenated UUID (e.g. "123e4567-e89b-12d3-a456-426614174000").
+var uuidRE = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ValidateFieldIsUUID validates that `value` is a canonical, hyphenated UUID,
+// returning a field.ErrorList with a single entry rooted at `fldPath` if it is not.
+func ValidateFieldIsUUID(fldPath *field.Path, value string) field.ErrorList {
+	if !uuidRE.MatchString(value) {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be a valid UUID")}
+	}
+	return nil
+}